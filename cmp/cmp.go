@@ -0,0 +1,150 @@
+/*
+Package cmp provides a small standard set of terst.Comparisons, for assertions
+that don't fit the built-in "==", "<", "=~" comparator table used by terst.Is.
+
+	terst.Is(cmp.DeepEqual(got, expect))
+	terst.MustIs(cmp.Len(items, 3))
+*/
+package cmp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/rickb777/terst"
+)
+
+// DeepEqual compares got and expect using reflect.DeepEqual.
+func DeepEqual(got, expect any) terst.Comparison {
+	return func() (bool, string) {
+		if reflect.DeepEqual(got, expect) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("\nFAIL (DeepEqual)\n     got: %#v\nexpected: %#v", got, expect)
+	}
+}
+
+// ErrorIs compares err against target using errors.Is.
+func ErrorIs(err, target error) terst.Comparison {
+	return func() (bool, string) {
+		if errors.Is(err, target) {
+			return true, ""
+		}
+		return false, fmt.Sprintf("\nFAIL (ErrorIs)\n     got: %v\nexpected: %v", err, target)
+	}
+}
+
+// ErrorAs compares err against target using errors.As. On success, target is
+// populated the same way errors.As would populate it, and the extracted
+// value is logged.
+func ErrorAs(err error, target any) terst.Comparison {
+	return func() (bool, string) {
+		if errors.As(err, target) {
+			return true, fmt.Sprintf("errors.As extracted: %s", extractedValue(target))
+		}
+		return false, fmt.Sprintf("\nFAIL (ErrorAs)\n     got: %v\nexpected: a value assignable to %T", err, derefTarget(target))
+	}
+}
+
+// derefTarget returns the value errors.As assigns into target (a **T) if it
+// succeeds, i.e. target dereferenced one level, from **T down to *T.
+func derefTarget(target any) any {
+	value := reflect.ValueOf(target)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		elem := value.Elem()
+		if elem.IsValid() && elem.CanInterface() {
+			return elem.Interface()
+		}
+	}
+	return target
+}
+
+// extractedValue renders the value errors.As populated into its target
+// pointer, for reporting once a comparison has succeeded.
+func extractedValue(target any) string {
+	return fmt.Sprintf("%v", derefTarget(target))
+}
+
+// Len asserts that got - an array, slice, map, string, or channel - has the
+// given length.
+func Len(got any, length int) terst.Comparison {
+	return func() (bool, string) {
+		value := reflect.ValueOf(got)
+		switch value.Kind() {
+		case reflect.Array, reflect.Slice, reflect.Map, reflect.String, reflect.Chan:
+			if value.Len() == length {
+				return true, ""
+			}
+			return false, fmt.Sprintf("\nFAIL (Len)\n     got: %d\nexpected: %d", value.Len(), length)
+		default:
+			return false, fmt.Sprintf("\nINVALID (Len): %T has no length", got)
+		}
+	}
+}
+
+// Contains asserts that got - a string, slice, array, or map - contains item.
+// For a string, item must also be a string and is matched as a substring.
+func Contains(got, item any) terst.Comparison {
+	return func() (bool, string) {
+		if got, ok := got.(string); ok {
+			if item, ok := item.(string); ok {
+				if strings.Contains(got, item) {
+					return true, ""
+				}
+				return false, fmt.Sprintf("\nFAIL (Contains)\n          got: %q\nexpected to contain: %q", got, item)
+			}
+		}
+
+		value := reflect.ValueOf(got)
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				if reflect.DeepEqual(value.Index(i).Interface(), item) {
+					return true, ""
+				}
+			}
+		case reflect.Map:
+			iter := value.MapRange()
+			for iter.Next() {
+				if reflect.DeepEqual(iter.Value().Interface(), item) {
+					return true, ""
+				}
+			}
+		default:
+			return false, fmt.Sprintf("\nINVALID (Contains): %T cannot contain anything", got)
+		}
+		return false, fmt.Sprintf("\nFAIL (Contains)\n          got: %#v\nexpected to contain: %#v", got, item)
+	}
+}
+
+// Panics asserts that fn panics when called.
+func Panics(fn func()) terst.Comparison {
+	return func() (success bool, message string) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				success, message = true, ""
+			}
+		}()
+		fn()
+		return false, "\nFAIL (Panics)\n     got: no panic\nexpected: a panic"
+	}
+}
+
+// Nil asserts that got is nil.
+func Nil(got any) terst.Comparison {
+	return func() (bool, string) {
+		if got == nil {
+			return true, ""
+		}
+		value := reflect.ValueOf(got)
+		switch value.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Map, reflect.Ptr, reflect.Slice, reflect.Interface:
+			if value.IsNil() {
+				return true, ""
+			}
+		}
+		return false, fmt.Sprintf("\nFAIL (Nil)\n     got: %v\nexpected: nil", got)
+	}
+}
@@ -0,0 +1,90 @@
+package cmp
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDeepEqual(t *testing.T) {
+	ok, _ := DeepEqual([]int{1, 2}, []int{1, 2})()
+	if !ok {
+		t.Fatal("expected equal slices to compare equal")
+	}
+	ok, msg := DeepEqual([]int{1, 2}, []int{1, 3})()
+	if ok || msg == "" {
+		t.Fatal("expected unequal slices to fail with a message")
+	}
+}
+
+func TestLen(t *testing.T) {
+	ok, _ := Len([]int{1, 2, 3}, 3)()
+	if !ok {
+		t.Fatal("expected Len to pass for a matching length")
+	}
+	ok, _ = Len(42, 1)()
+	if ok {
+		t.Fatal("expected Len to fail for a lengthless type")
+	}
+}
+
+func TestContains(t *testing.T) {
+	ok, _ := Contains("hello world", "world")()
+	if !ok {
+		t.Fatal("expected Contains to find the substring")
+	}
+	ok, _ = Contains([]int{1, 2, 3}, 2)()
+	if !ok {
+		t.Fatal("expected Contains to find the slice element")
+	}
+}
+
+func TestPanics(t *testing.T) {
+	ok, _ := Panics(func() { panic("boom") })()
+	if !ok {
+		t.Fatal("expected Panics to report success when fn panics")
+	}
+	ok, _ = Panics(func() {})()
+	if ok {
+		t.Fatal("expected Panics to fail when fn does not panic")
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	var target *myErr
+	ok, msg := ErrorAs(&myErr{}, &target)()
+	if !ok {
+		t.Fatal("expected ErrorAs to succeed")
+	}
+	if msg == "" {
+		t.Fatal("expected ErrorAs to report the extracted value on success")
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	wrapped := errors.New("wrapping")
+	ok, _ := ErrorIs(sentinel, sentinel)()
+	if !ok {
+		t.Fatal("expected ErrorIs to succeed when err is target")
+	}
+	ok, msg := ErrorIs(wrapped, sentinel)()
+	if ok || msg == "" {
+		t.Fatal("expected ErrorIs to fail for an unrelated error")
+	}
+}
+
+func TestNil(t *testing.T) {
+	var p *myErr
+	ok, _ := Nil(p)()
+	if !ok {
+		t.Fatal("expected Nil to succeed for a nil pointer")
+	}
+	ok, msg := Nil(&myErr{})()
+	if ok || msg == "" {
+		t.Fatal("expected Nil to fail for a non-nil pointer")
+	}
+}
+
+type myErr struct{}
+
+func (*myErr) Error() string { return "myErr" }
@@ -0,0 +1,81 @@
+package terst
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+var errSentinel = errors.New("sentinel")
+
+func TestCompareErrorsIs(t *testing.T) {
+	wrapped := fmt.Errorf("wrapping: %w", errSentinel)
+	if err := IsErr(wrapped, "errors.Is", errSentinel); err != nil {
+		t.Fatalf("expected errors.Is to match the wrapped sentinel, got: %v", err)
+	}
+	if err := IsErr(wrapped, "errors.Is", errors.New("other")); err == nil {
+		t.Fatal("expected errors.Is to fail for an unrelated error")
+	}
+}
+
+type myErr struct{ msg string }
+
+func (e *myErr) Error() string { return e.msg }
+
+func TestCompareErrorsAsSucceedsAndReportsTarget(t *testing.T) {
+	wrapped := fmt.Errorf("wrapping: %w", &myErr{msg: "boom"})
+	var target *myErr
+	if err := IsErr(wrapped, "errors.As", &target); err != nil {
+		t.Fatalf("expected errors.As to succeed, got: %v", err)
+	}
+	if target == nil || target.msg != "boom" {
+		t.Fatalf("expected errors.As to populate target, got: %+v", target)
+	}
+}
+
+func TestCompareErrorsAsFailureMessageNamesTheTargetType(t *testing.T) {
+	var target *myErr
+	err := IsErr(errors.New("plain"), "errors.As", &target)
+	if err == nil {
+		t.Fatal("expected errors.As to fail for an unrelated error")
+	}
+	if !strings.Contains(err.Error(), "expected: a value assignable to *terst.myErr") {
+		t.Fatalf("expected the FAIL message to name the dereferenced target type *terst.myErr, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "**terst.myErr") {
+		t.Fatalf("expected the FAIL message not to show an extra pointer level, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "0x") {
+		t.Fatalf("expected the FAIL message not to print a raw pointer, got: %v", err)
+	}
+}
+
+func TestComparePanicsSucceeds(t *testing.T) {
+	if err := IsErr(func() { panic("boom") }, "panics", "boom"); err != nil {
+		t.Fatalf("expected panics to match, got: %v", err)
+	}
+}
+
+func TestComparePanicsFailureMessageShowsRecoveredValue(t *testing.T) {
+	err := IsErr(func() { panic("boom") }, "panics", "nope")
+	if err == nil {
+		t.Fatal("expected panics to fail when the recovered value doesn't match")
+	}
+	if !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("expected the FAIL message to show the recovered value, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "func()") {
+		t.Fatalf("expected the FAIL message not to show the closure itself, got: %v", err)
+	}
+}
+
+func TestComparePanicsFailureMessageForNoPanic(t *testing.T) {
+	err := IsErr(func() {}, "panics", "boom")
+	if err == nil {
+		t.Fatal("expected panics to fail when fn does not panic")
+	}
+	if !strings.Contains(err.Error(), "no panic") {
+		t.Fatalf("expected the FAIL message to say 'no panic', got: %v", err)
+	}
+}
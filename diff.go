@@ -0,0 +1,179 @@
+package terst
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+)
+
+var diffEnabled atomic.Bool
+
+func init() {
+	diffEnabled.Store(true)
+}
+
+// SetDiff toggles whether equality failures ("==", "!=") on structs, slices,
+// maps, and arrays are reported with a unified line diff of the two values,
+// in addition to the usual got/expected lines. It is enabled by default.
+// Safe to call concurrently with assertions, e.g. from a parallel subtest.
+func SetDiff(enabled bool) {
+	diffEnabled.Store(enabled)
+}
+
+// isComposite reports whether value's kind benefits from a structured diff
+// rather than a single-line %v dump.
+func isComposite(value any) bool {
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Map, reflect.Array:
+		return true
+	}
+	return false
+}
+
+// formatDiff renders a unified diff between got and expect. Both must be
+// composite (see isComposite); mismatched types produce a diff of their
+// dumps regardless, which is still informative.
+func formatDiff(got, expect any) string {
+	gotLines := strings.Split(dump(reflect.ValueOf(got), ""), "\n")
+	expectLines := strings.Split(dump(reflect.ValueOf(expect), ""), "\n")
+	if len(gotLines) == 1 && len(expectLines) == 1 {
+		return ""
+	}
+	var out strings.Builder
+	for _, op := range diffOps(gotLines, expectLines) {
+		switch op.kind {
+		case diffEqual:
+			out.WriteString("  ")
+		case diffDelete:
+			out.WriteString("- ")
+		case diffInsert:
+			out.WriteString("+ ")
+		}
+		out.WriteString(op.line)
+		out.WriteByte('\n')
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// dump renders value as deterministic, indented, multi-line text: map keys
+// are sorted and struct fields keep their declared order, so two dumps of
+// equal values are always byte-identical and can be diffed line-by-line.
+func dump(value reflect.Value, indent string) string {
+	if !value.IsValid() {
+		return "nil"
+	}
+	if !value.CanInterface() {
+		// An unexported struct field: reading it via reflection would panic
+		// ("cannot return value obtained from unexported field or method"),
+		// so report its presence without its value.
+		return "<unexported>"
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return "nil"
+		}
+		return dump(value.Elem(), indent)
+
+	case reflect.Struct:
+		inner := indent + "\t"
+		var b strings.Builder
+		fmt.Fprintf(&b, "%s{\n", value.Type().Name())
+		for i := 0; i < value.NumField(); i++ {
+			fmt.Fprintf(&b, "%s%s: %s\n", inner, value.Type().Field(i).Name, dump(value.Field(i), inner))
+		}
+		fmt.Fprintf(&b, "%s}", indent)
+		return b.String()
+
+	case reflect.Slice, reflect.Array:
+		inner := indent + "\t"
+		var b strings.Builder
+		b.WriteString("[\n")
+		for i := 0; i < value.Len(); i++ {
+			fmt.Fprintf(&b, "%s%s,\n", inner, dump(value.Index(i), inner))
+		}
+		fmt.Fprintf(&b, "%s]", indent)
+		return b.String()
+
+	case reflect.Map:
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+		})
+		inner := indent + "\t"
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, key := range keys {
+			fmt.Fprintf(&b, "%s%v: %s,\n", inner, key.Interface(), dump(value.MapIndex(key), inner))
+		}
+		fmt.Fprintf(&b, "%s}", indent)
+		return b.String()
+
+	default:
+		return fmt.Sprintf("%v", value.Interface())
+	}
+}
+
+type diffKind int
+
+const (
+	diffEqual diffKind = iota
+	diffDelete
+	diffInsert
+)
+
+type diffOp struct {
+	kind diffKind
+	line string
+}
+
+// diffOps computes the longest common subsequence of a and b with the usual
+// O(len(a)*len(b)) dynamic-programming table, then replays it forward into a
+// minimal sequence of equal/delete/insert operations - a simple Hunt-McIlroy
+// style line diff, good enough for the handful of lines a dump produces.
+func diffOps(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	table := make([][]int, n+1)
+	for i := range table {
+		table[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				table[i][j] = table[i+1][j+1] + 1
+			case table[i+1][j] >= table[i][j+1]:
+				table[i][j] = table[i+1][j]
+			default:
+				table[i][j] = table[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, n+m)
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{diffEqual, a[i]})
+			i++
+			j++
+		case table[i+1][j] >= table[i][j+1]:
+			ops = append(ops, diffOp{diffDelete, a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{diffInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{diffDelete, a[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{diffInsert, b[j]})
+	}
+	return ops
+}
@@ -0,0 +1,50 @@
+package terst
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestFormatDiffStruct(t *testing.T) {
+	d := formatDiff(point{X: 1, Y: 2}, point{X: 1, Y: 3})
+	if !strings.Contains(d, "- ") || !strings.Contains(d, "+ ") {
+		t.Fatalf("expected a diff with - and + lines, got:\n%s", d)
+	}
+	if !strings.Contains(d, "Y: 2") || !strings.Contains(d, "Y: 3") {
+		t.Fatalf("expected the differing field to appear on both sides, got:\n%s", d)
+	}
+}
+
+func TestDumpDoesNotPanicOnUnexportedFields(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("dump panicked on a value with unexported fields: %v", r)
+		}
+	}()
+	formatDiff(time.Now(), time.Now())
+}
+
+func TestIsErrReportsDiffForUnequalStructs(t *testing.T) {
+	err := IsErr(point{X: 1, Y: 2}, point{X: 1, Y: 3})
+	if err == nil {
+		t.Fatal("expected unequal points to fail")
+	}
+	if !strings.Contains(err.Error(), "Y: 2") {
+		t.Fatalf("expected the FAIL message to include a diff, got: %v", err)
+	}
+}
+
+func TestSetDiffDisablesDiffOutput(t *testing.T) {
+	SetDiff(false)
+	defer SetDiff(true)
+
+	err := IsErr(point{X: 1, Y: 2}, point{X: 1, Y: 3})
+	if strings.Contains(err.Error(), "\n- ") || strings.Contains(err.Error(), "\n+ ") {
+		t.Fatalf("expected no diff output once SetDiff(false), got: %v", err)
+	}
+}
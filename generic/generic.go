@@ -0,0 +1,143 @@
+/*
+Package generic is a type-safe, generics-based parallel to terst's variadic
+Is/Compare API. Where Is coerces got and expect across types (promoting
+through big.Int in ways that can surprise, e.g.
+
+	terst.Compare(uint64(math.MaxUint64), "<", int64(math.MinInt32))
+
+the functions here require got and expect to already be the same type, so
+the comparison performed is always the one the call site reads:
+
+	generic.Equal(a, b)
+	generic.Less(a, b)
+	generic.SliceEqual(got, expect)
+
+Requires Go 1.21 or later.
+*/
+package generic
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/rickb777/terst"
+)
+
+// Ordered matches any type supporting the <, <=, >, and >= operators.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// fail reports a comparison failure the same way terst.Is does: through the
+// enclosing Terst scope if there is one, or by panicking if called outside
+// one.
+func fail(call *terst.Call, comparator string, got, expect any) bool {
+	if call != nil {
+		call.T().Helper()
+	}
+	err := fmt.Errorf("\nFAIL (%s)\n     got: %v (%T)\nexpected: %v (%T)", comparator, got, got, expect, expect)
+	if call == nil {
+		panic(err)
+	}
+	call.Error(err)
+	return false
+}
+
+// Equal asserts that got == expect.
+func Equal[T comparable](got, expect T) bool {
+	call := terst.Caller()
+	if call != nil {
+		call.T().Helper()
+	}
+	if got == expect {
+		return true
+	}
+	return fail(call, "==", got, expect)
+}
+
+// NotEqual asserts that got != expect.
+func NotEqual[T comparable](got, expect T) bool {
+	call := terst.Caller()
+	if call != nil {
+		call.T().Helper()
+	}
+	if got != expect {
+		return true
+	}
+	return fail(call, "!=", got, expect)
+}
+
+// Less asserts that got < expect.
+func Less[T Ordered](got, expect T) bool {
+	call := terst.Caller()
+	if call != nil {
+		call.T().Helper()
+	}
+	if got < expect {
+		return true
+	}
+	return fail(call, "<", got, expect)
+}
+
+// DeepEqual asserts that got and expect are reflect.DeepEqual.
+func DeepEqual[T any](got, expect T) bool {
+	call := terst.Caller()
+	if call != nil {
+		call.T().Helper()
+	}
+	if reflect.DeepEqual(got, expect) {
+		return true
+	}
+	return fail(call, "DeepEqual", got, expect)
+}
+
+// SliceEqual asserts that got and expect hold equal elements in the same order.
+func SliceEqual[T comparable](got, expect []T) bool {
+	call := terst.Caller()
+	if call != nil {
+		call.T().Helper()
+	}
+	if sliceEqual(got, expect) {
+		return true
+	}
+	return fail(call, "SliceEqual", got, expect)
+}
+
+func sliceEqual[T comparable](got, expect []T) bool {
+	if len(got) != len(expect) {
+		return false
+	}
+	for i := range got {
+		if got[i] != expect[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MapEqual asserts that got and expect hold the same key/value pairs.
+func MapEqual[K comparable, V any](got, expect map[K]V) bool {
+	call := terst.Caller()
+	if call != nil {
+		call.T().Helper()
+	}
+	if mapEqual(got, expect) {
+		return true
+	}
+	return fail(call, "MapEqual", got, expect)
+}
+
+func mapEqual[K comparable, V any](got, expect map[K]V) bool {
+	if len(got) != len(expect) {
+		return false
+	}
+	for k, v := range got {
+		ev, ok := expect[k]
+		if !ok || !reflect.DeepEqual(v, ev) {
+			return false
+		}
+	}
+	return true
+}
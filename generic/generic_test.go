@@ -0,0 +1,58 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/rickb777/terst"
+)
+
+func TestEqual(t *testing.T) {
+	terst.Terst(t, func() {
+		if !Equal(1, 1) {
+			t.Fatal("expected Equal(1, 1) to pass")
+		}
+	})
+}
+
+func TestEqualFailsOutsideTerstScopeByPanicking(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected a failing Equal outside a Terst scope to panic")
+		}
+	}()
+	Equal(1, 2)
+}
+
+func TestLess(t *testing.T) {
+	terst.Terst(t, func() {
+		if !Less(1, 2) {
+			t.Fatal("expected Less(1, 2) to pass")
+		}
+	})
+}
+
+func TestDeepEqual(t *testing.T) {
+	terst.Terst(t, func() {
+		if !DeepEqual([]int{1, 2}, []int{1, 2}) {
+			t.Fatal("expected DeepEqual of equal slices to pass")
+		}
+	})
+}
+
+func TestSliceEqual(t *testing.T) {
+	terst.Terst(t, func() {
+		if !SliceEqual([]int{1, 2, 3}, []int{1, 2, 3}) {
+			t.Fatal("expected SliceEqual to pass for matching slices")
+		}
+	})
+}
+
+func TestMapEqual(t *testing.T) {
+	terst.Terst(t, func() {
+		got := map[string]int{"a": 1, "b": 2}
+		expect := map[string]int{"a": 1, "b": 2}
+		if !MapEqual(got, expect) {
+			t.Fatal("expected MapEqual to pass for matching maps")
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package terst
+
+import "testing"
+
+func TestRunExecutesNamedSubtest(t *testing.T) {
+	ran := false
+	Terst(t, func() {
+		Run("sub1", func() {
+			ran = true
+			Is(1, 1)
+		})
+	})
+	if !ran {
+		t.Fatal("expected Run to execute its func()")
+	}
+}
+
+func TestTerstAcceptsNamedBlocks(t *testing.T) {
+	var order []string
+	Terst(t,
+		"first", func() {
+			order = append(order, "first")
+			Is(1, 1)
+		},
+		"second", func() {
+			order = append(order, "second")
+			Is(2, 2)
+		},
+	)
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Fatalf("expected both named blocks to run in order, got %v", order)
+	}
+}
+
+func TestRunPanicsOutsideTerstScope(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Run to panic outside a Terst scope")
+		}
+	}()
+	Run("orphan", func() {})
+}
@@ -0,0 +1,40 @@
+package terst
+
+import "testing"
+
+func TestCallerIsNilOutsideTerst(t *testing.T) {
+	if Caller() != nil {
+		t.Fatal("expected Caller() to be nil outside a Terst scope")
+	}
+}
+
+func TestCallerIsScopedToItsGoroutine(t *testing.T) {
+	Terst(t, func() {
+		if Caller() == nil {
+			t.Fatal("expected Caller() to find the enclosing scope")
+		}
+		other := make(chan *Call)
+		go func() { other <- Caller() }()
+		if <-other != nil {
+			t.Fatal("expected Caller() to be nil on a goroutine the scope is not registered for")
+		}
+	})
+}
+
+func TestScopeIsDeregisteredAfterTerst(t *testing.T) {
+	t.Run("sub", func(sub *testing.T) {
+		// t.Cleanup funcs run LIFO, so registering this one before calling
+		// Terst means it runs after registerScope's own deregistration
+		// cleanup - i.e. once the scope should already be gone.
+		sub.Cleanup(func() {
+			if Caller() != nil {
+				t.Error("expected the scope to be deregistered by the time later cleanups run")
+			}
+		})
+		Terst(sub, func() {
+			if Caller() == nil {
+				t.Fatal("expected Caller() to find the scope during the sub-test")
+			}
+		})
+	})
+}
@@ -16,29 +16,28 @@ terst is compatible with (and works via) the standard testing package: http://go
         }
     }
 
-Do not import terst directly, instead use `terst-import` to copy it into your testing environment:
+Import it the normal Go modules way:
 
-https://github.com/robertkrimen/terst/tree/master/terst-import
+    $ go get github.com/rickb777/terst
 
-    $ go get github.com/robertkrimen/terst/terst-import
-
-    $ terst-import
+terst requires Go 1.21 or later. For a type-safe, generics-based
+alternative to the variadic Is/Compare API, see the terst/generic
+sub-package.
 
 */
 package terst
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
 	"reflect"
 	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
-	"time"
 )
 
 // Is compares two values (got & expect) and returns true if the comparison is true,
@@ -58,6 +57,12 @@ import (
 //      =~      # regexp.MustCompile(expect).Match{String}(got)
 //      !~      # !regexp.MustCompile(expect).Match{String}(got)
 //
+//      errors.Is       # errors.Is(got, expect); got and expect are both errors
+//      errors.As       # errors.As(got, expect); got is an error, expect a **T
+//      panics          # got is a func(); it is run and its recover()ed value
+//                       # is matched against expect (a string, *regexp.Regexp,
+//                       # or error)
+//
 // Basic usage with the default comparator (`==`):
 //
 //      Is(<got>, <expect>)
@@ -79,10 +84,18 @@ import (
 // Is should only be called under a Terst(t, ...) call. For a standalone version,
 // use IsErr(...). If no scope is found and the comparison is false, then Is will panic the error.
 //
-func Is(arguments ...interface{}) bool {
+func Is(arguments ...any) bool {
+	call := Caller()
+	if call != nil {
+		call.scope.t.Helper()
+	}
+	if len(arguments) == 1 {
+		if comparison, ok := arguments[0].(Comparison); ok {
+			return checkComparison(call, comparison)
+		}
+	}
 	err := IsErr(arguments...)
 	if err != nil {
-		call := Caller()
 		if call == nil {
 			panic(err)
 		}
@@ -92,6 +105,170 @@ func Is(arguments ...interface{}) bool {
 	return true
 }
 
+// Comparison is a user-supplied comparison for use with Is and MustIs, for
+// cases the built-in comparator table (==, <, =~, ...) doesn't cover. A
+// Comparison reports success and, on failure, the message to report, e.g.
+//
+//      Is(cmp.DeepEqual(got, expect))
+//      MustIs(cmp.Len(items, 3))
+//
+// See the terst/cmp sub-package for a standard set of Comparisons.
+type Comparison func() (success bool, message string)
+
+func checkComparison(call *Call, comparison Comparison) bool {
+	if call != nil {
+		call.scope.t.Helper()
+	}
+	success, message := comparison()
+	if success {
+		if message != "" && call != nil {
+			call.Log(message)
+		}
+		return true
+	}
+	err := ErrFail(errors.New(message))
+	if call == nil {
+		panic(err)
+	}
+	call.Error(err)
+	return false
+}
+
+// Compare is Is with an explicit comparator; it reads better than Is at call
+// sites that are not testing plain equality, e.g. Compare(got, ">", 0).
+func Compare(arguments ...any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return Is(arguments...)
+}
+
+// Equal asserts that got == expect using the default comparator.
+func Equal(got, expect any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return Is(got, expect)
+}
+
+// Unlike asserts that got != expect.
+func Unlike(got, expect any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return Is(got, "!=", expect)
+}
+
+// Match asserts that got matches the regular expression expect.
+func Match(got, expect any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return Is(got, "=~", expect)
+}
+
+// Pass asserts that condition is true.
+func Pass(condition bool) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return Is(condition, true)
+}
+
+// Fail asserts that condition is false.
+func Fail(condition bool) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return Is(condition, false)
+}
+
+// MustIs behaves like Is, except that a failing comparison calls
+// testing.T.FailNow (via Call.Errorf) instead of testing.T.Fail, aborting the
+// current scope immediately. Use it for preconditions that later assertions
+// in the same scope depend on, matching the Assert/Check split in
+// gotest.tools/assert.
+func MustIs(arguments ...any) bool {
+	call := Caller()
+	if call != nil {
+		call.scope.t.Helper()
+	}
+	if len(arguments) == 1 {
+		if comparison, ok := arguments[0].(Comparison); ok {
+			return mustCheckComparison(call, comparison)
+		}
+	}
+	err := IsErr(arguments...)
+	if err != nil {
+		if call == nil {
+			panic(err)
+		}
+		mustFail(call, err)
+		return false
+	}
+	return true
+}
+
+func mustCheckComparison(call *Call, comparison Comparison) bool {
+	if call != nil {
+		call.scope.t.Helper()
+	}
+	success, message := comparison()
+	if success {
+		if message != "" && call != nil {
+			call.Log(message)
+		}
+		return true
+	}
+	err := ErrFail(errors.New(message))
+	if call == nil {
+		panic(err)
+	}
+	mustFail(call, err)
+	return false
+}
+
+// mustFail reports err the same way Call.Error does, then aborts the current
+// scope with FailNow so execution does not continue past a failed
+// precondition.
+func mustFail(call *Call, err error) {
+	call.scope.t.Helper()
+	call.Errorf("%s", err)
+	call.scope.t.FailNow()
+}
+
+// MustCompare is the fail-fast counterpart to Compare.
+func MustCompare(arguments ...any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return MustIs(arguments...)
+}
+
+// MustEqual is the fail-fast counterpart to Equal.
+func MustEqual(got, expect any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return MustIs(got, expect)
+}
+
+// MustUnlike is the fail-fast counterpart to Unlike.
+func MustUnlike(got, expect any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return MustIs(got, "!=", expect)
+}
+
+// MustMatch is the fail-fast counterpart to Match.
+func MustMatch(got, expect any) bool {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+	}
+	return MustIs(got, "=~", expect)
+}
+
 type (
 	// ErrFail indicates a comparison failure (e.g. 0 > 1).
 	ErrFail error
@@ -102,22 +279,45 @@ type (
 
 var errInvalid = errors.New("invalid")
 
-var registry = struct {
-	table map[uintptr]*_scope
-	lock  sync.RWMutex
-}{
-	table: map[uintptr]*_scope{},
-}
+// scopes maps a running goroutine's id to the *_scope it is currently
+// executing under. testing.T.Run always runs its callback on a new
+// goroutine (even without t.Parallel()), so this also gives sub-tests their
+// own entry for free.
+var scopes sync.Map // map[int64]*_scope
 
-func registerScope(pc uintptr, scope *_scope) {
-	registry.lock.Lock()
-	defer registry.lock.Unlock()
-	registry.table[pc] = scope
-}
-
-func scope() *_scope {
-	scope, _ := findScope()
-	return scope
+// currentScope returns the scope registered for the calling goroutine, or
+// nil if none is found - i.e. the call did not happen under Terst(...).
+func currentScope() *_scope {
+	value, ok := scopes.Load(goroutineID())
+	if !ok {
+		return nil
+	}
+	return value.(*_scope)
+}
+
+// registerScope associates scope with the calling goroutine for the
+// lifetime of t, using t.Cleanup to deregister it - no PC table to leak.
+func registerScope(t *testing.T, scope *_scope) {
+	id := goroutineID()
+	scopes.Store(id, scope)
+	t.Cleanup(func() {
+		scopes.Delete(id)
+	})
+}
+
+// goroutineID extracts the calling goroutine's id from its stack trace
+// header ("goroutine 7 [running]: ..."). This is the usual trick for
+// goroutine-local state in the absence of a language-level equivalent; it is
+// only ever used to key the scope map, never for synchronization.
+func goroutineID() int64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	fields := strings.Fields(string(buf[:n]))
+	if len(fields) < 2 {
+		return 0
+	}
+	id, _ := strconv.ParseInt(fields[1], 10, 64)
+	return id
 }
 
 func floatCompare(a float64, b float64) int {
@@ -146,7 +346,7 @@ type _toString interface {
 	String() string
 }
 
-func toString(value interface{}) (string, error) {
+func toString(value any) (string, error) {
 	switch value := value.(type) {
 	case string:
 		return value, nil
@@ -165,7 +365,7 @@ func match(got string, expect *regexp.Regexp) (int, error) {
 	return -1, nil
 }
 
-func compareMatch(got, expect interface{}) (int, error) {
+func compareMatch(got, expect any) (int, error) {
 	if got, err := toString(got); err != nil {
 		switch expect := expect.(type) {
 		case string:
@@ -185,6 +385,71 @@ invalid:
 	return 0, errInvalid
 }
 
+func compareErrorsIs(got, expect any) (int, error) {
+	gotErr, ok := got.(error)
+	if !ok {
+		return 0, errInvalid
+	}
+	targetErr, ok := expect.(error)
+	if !ok {
+		return 0, errInvalid
+	}
+	if errors.Is(gotErr, targetErr) {
+		return 0, nil
+	}
+	return -1, nil
+}
+
+func compareErrorsAs(got, expect any) (int, error) {
+	gotErr, ok := got.(error)
+	if !ok {
+		return 0, errInvalid
+	}
+	if errors.As(gotErr, expect) {
+		return 0, nil
+	}
+	return -1, nil
+}
+
+// comparePanics runs got (a func()) and matches whatever it recover()s
+// against expect: a string or *regexp.Regexp is matched the same way =~
+// matches a string, an error is matched with errors.Is. recovered is
+// returned alongside the result so callers can report it in place of the
+// closure itself.
+func comparePanics(got, expect any) (result int, recovered any, err error) {
+	fn, ok := got.(func())
+	if !ok {
+		return 0, nil, errInvalid
+	}
+
+	func() {
+		defer func() {
+			recovered = recover()
+		}()
+		fn()
+	}()
+
+	if recovered == nil {
+		return -1, nil, nil
+	}
+
+	switch expect := expect.(type) {
+	case string:
+		result, err = match(fmt.Sprint(recovered), regexp.MustCompile(expect))
+	case *regexp.Regexp:
+		result, err = match(fmt.Sprint(recovered), expect)
+	case error:
+		if recoveredErr, ok := recovered.(error); ok && errors.Is(recoveredErr, expect) {
+			result, err = 0, nil
+		} else {
+			result, err = -1, nil
+		}
+	default:
+		result, err = 0, errInvalid
+	}
+	return result, recovered, err
+}
+
 func floatPromote(value reflect.Value) (float64, error) {
 	kind := value.Kind()
 	if reflect.Int <= kind && kind <= reflect.Int64 {
@@ -210,7 +475,7 @@ func bigIntPromote(value reflect.Value) (*big.Int, error) {
 	return nil, errInvalid
 }
 
-func compareOther(got, expect interface{}) (int, error) {
+func compareOther(got, expect any) (int, error) {
 	{
 		switch expect.(type) {
 		case float32, float64:
@@ -233,7 +498,7 @@ func compareOther(got, expect interface{}) (int, error) {
 					return 0, nil
 				}
 				return -1, nil
-			case reflect.Invalid: // reflect.Invalid: var abc interface{} = nil
+			case reflect.Invalid: // reflect.Invalid: var abc any = nil
 				return 0, nil
 			}
 			return 0, errInvalid
@@ -250,45 +515,40 @@ func compareOther(got, expect interface{}) (int, error) {
 	return -1, nil
 }
 
-func compareNumber(got, expect interface{}) (int, error) {
-	{
-		got := reflect.ValueOf(got)
-		k0 := got.Kind()
-		expect := reflect.ValueOf(expect)
-		k1 := expect.Kind()
-		if reflect.Float32 <= k0 && k0 <= reflect.Float64 ||
-			reflect.Float32 <= k1 && k1 <= reflect.Float64 {
-			got, err := floatPromote(got)
-			if err != nil {
-				return 0, err
-			}
-			expect, err := floatPromote(expect)
-			if err != nil {
-				return 0, err
-			}
-			return floatCompare(got, expect), nil
-		} else {
-			got, err := bigIntPromote(got)
-			if err != nil {
-				return 0, err
-			}
-			expect, err := bigIntPromote(expect)
-			if err != nil {
-				return 0, err
-			}
-			return got.Cmp(expect), nil
+func compareNumber(got, expect any) (int, error) {
+	got0 := reflect.ValueOf(got)
+	k0 := got0.Kind()
+	expect0 := reflect.ValueOf(expect)
+	k1 := expect0.Kind()
+	if reflect.Float32 <= k0 && k0 <= reflect.Float64 ||
+		reflect.Float32 <= k1 && k1 <= reflect.Float64 {
+		got, err := floatPromote(got0)
+		if err != nil {
+			return 0, err
 		}
+		expect, err := floatPromote(expect0)
+		if err != nil {
+			return 0, err
+		}
+		return floatCompare(got, expect), nil
 	}
-
-	return 0, errInvalid
+	gotBig, err := bigIntPromote(got0)
+	if err != nil {
+		return 0, err
+	}
+	expectBig, err := bigIntPromote(expect0)
+	if err != nil {
+		return 0, err
+	}
+	return gotBig.Cmp(expectBig), nil
 }
 
 // IsErr compares two values (got & expect) and returns nil if the comparison is true, an ErrFail if
 // the comparison is false, or an ErrInvalid if the comparison is invalid. IsErr also
 // takes an optional argument, a comparator, that changes how the comparison is made.
 //
-func IsErr(arguments ...interface{}) error {
-	var got, expect interface{}
+func IsErr(arguments ...any) error {
+	var got, expect any
 	comparator := "=="
 	switch len(arguments) {
 	case 0, 1:
@@ -306,6 +566,7 @@ func IsErr(arguments ...interface{}) error {
 
 	var result int
 	var err error
+	var panicRecovered any
 
 	switch comparator {
 	case "<", "<=", ">", ">=":
@@ -314,6 +575,12 @@ func IsErr(arguments ...interface{}) error {
 		result, err = compareMatch(got, expect)
 	case "==", "!=":
 		result, err = compareOther(got, expect)
+	case "errors.Is":
+		result, err = compareErrorsIs(got, expect)
+	case "errors.As":
+		result, err = compareErrorsAs(got, expect)
+	case "panics":
+		result, panicRecovered, err = comparePanics(got, expect)
 	default:
 		return fmt.Errorf("invalid comparator: %s", comparator)
 	}
@@ -332,7 +599,7 @@ func IsErr(arguments ...interface{}) error {
 	equality, pass := false, false
 
 	switch comparator {
-	case "==", "=~":
+	case "==", "=~", "errors.Is", "errors.As", "panics":
 		equality = true
 		pass = result == 0
 	case "!=", "!~":
@@ -348,14 +615,39 @@ func IsErr(arguments ...interface{}) error {
 		pass = result >= 0
 	}
 
+	if pass && comparator == "errors.As" {
+		logExtracted(extractedValue(expect))
+	}
+
 	if !pass {
 		if equality {
-			return ErrFail(fmt.Errorf(
+			gotStr, expectStr := got, expect
+			switch comparator {
+			case "panics":
+				if panicRecovered == nil {
+					message := fmt.Sprintf("\nFAIL (%s)\n     got: no panic\nexpected: %v%s",
+						comparator, expect, typeKindString(expect))
+					return ErrFail(errors.New(message))
+				}
+				gotStr = panicRecovered
+			case "errors.As":
+				message := fmt.Sprintf("\nFAIL (%s)\n     got: %v%s\nexpected: a value assignable to %T",
+					comparator, got, typeKindString(got), derefTarget(expect))
+				return ErrFail(errors.New(message))
+			}
+
+			message := fmt.Sprintf(
 				"\nFAIL (%s)\n     got: %v%s\nexpected: %v%s",
 				comparator,
-				got, typeKindString(got),
-				expect, typeKindString(expect),
-			))
+				gotStr, typeKindString(gotStr),
+				expectStr, typeKindString(expectStr),
+			)
+			if (comparator == "==" || comparator == "!=") && diffEnabled.Load() && isComposite(got) && isComposite(expect) {
+				if d := formatDiff(got, expect); d != "" {
+					message += "\n" + d
+				}
+			}
+			return ErrFail(errors.New(message))
 		}
 		return ErrFail(fmt.Errorf(
 			"\nFAIL (%s)\n     got: %v%s\nexpected: %s %v%s",
@@ -368,7 +660,35 @@ func IsErr(arguments ...interface{}) error {
 	return nil
 }
 
-func typeKindString(value interface{}) string {
+// derefTarget returns the value errors.As assigns into target (a **T) if it
+// succeeds, i.e. target dereferenced one level, from **T down to *T.
+func derefTarget(target any) any {
+	value := reflect.ValueOf(target)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		elem := value.Elem()
+		if elem.IsValid() && elem.CanInterface() {
+			return elem.Interface()
+		}
+	}
+	return target
+}
+
+// extractedValue renders the value errors.As populated into its **T target,
+// for reporting once a comparison has succeeded.
+func extractedValue(target any) string {
+	return fmt.Sprintf("%v", derefTarget(target))
+}
+
+// logExtracted reports message via the enclosing Terst scope, if any; it is
+// a no-op when called outside one (e.g. from a standalone IsErr call).
+func logExtracted(message string) {
+	if call := Caller(); call != nil {
+		call.scope.t.Helper()
+		call.Logf("errors.As extracted: %s", message)
+	}
+}
+
+func typeKindString(value any) string {
 	reflectValue := reflect.ValueOf(value)
 	kind := reflectValue.Kind().String()
 	result := fmt.Sprintf("%T", value)
@@ -381,13 +701,6 @@ func typeKindString(value interface{}) string {
 	return fmt.Sprintf(" (%T=%s)", value, kind)
 }
 
-func (scope *_scope) reset() {
-	scope.name = ""
-	scope.output = scope.output[:]
-	scope.start = time.Time{}
-	scope.duration = 0
-}
-
 // Terst creates a testing scope, where Is can be called and errors will be reported
 // according to the top-level location of the comparison, and not where the Is call
 // actually takes place. For example:
@@ -404,223 +717,120 @@ func (scope *_scope) reset() {
 //
 //      })
 //
-func Terst(t *testing.T, arguments ...func()) {
-	scope := &_scope{
-		t: t,
-	}
-
-	pc, _, _, ok := runtime.Caller(1) // TODO Associate with the Test... func
-	if !ok {
-		panic("Here be dragons.")
-	}
+// Arguments are either anonymous scopes (func()) or named ones, given as a
+// string immediately followed by a func() - the same pairing accepted by
+// Run, so a body can be split into named sub-tests without calling Run
+// itself:
+//
+//      Terst(t,
+//          "slowcase", func(){ ... },
+//          "fastcase", func(){ ... },
+//      )
+//
+func Terst(t *testing.T, arguments ...any) {
+	t.Helper()
+	scope := &_scope{t: t}
+	registerScope(t, scope)
 
-	_, scope.testFunc = findTestFunc()
+	for i := 0; i < len(arguments); i++ {
+		switch argument := arguments[i].(type) {
+		case func():
+			argument()
 
-	registerScope(pc, scope)
+		case string:
+			if i+1 >= len(arguments) {
+				panic(fmt.Sprintf("terst.Terst: name %q has no matching func()", argument))
+			}
+			body, ok := arguments[i+1].(func())
+			if !ok {
+				panic(fmt.Sprintf("terst.Terst: expected func() after name %q, got %T", argument, arguments[i+1]))
+			}
+			Run(argument, body)
+			i++
 
-	for _, fn := range arguments {
-		func() {
-			scope.reset()
-			scope.name = "-"
-			scope.start = time.Now()
-			defer func() {
-				scope.duration = time.Now().Sub(scope.start)
-				if err := recover(); err != nil {
-					scope.t.Fail()
-					scope.report()
-					panic(err)
-				}
-				scope.report()
-			}()
-			fn()
-		}()
+		default:
+			panic(fmt.Sprintf("terst.Terst: unsupported argument type %T", argument))
+		}
 	}
 }
 
-// From "testing"
-func (scope *_scope) report() {
-	tstr := fmt.Sprintf("(%.2f seconds)", scope.duration.Seconds())
-	format := "--- %s: %s %s\n%s"
-	if scope.t.Failed() {
-		fmt.Printf(format, "FAIL", scope.name, tstr, scope.output)
-	} else if testing.Verbose() {
-		fmt.Printf(format, "PASS", scope.name, tstr, scope.output)
+// Run runs fn as a named sub-test of the enclosing Terst scope, via
+// testing.T.Run, so that failures report against the sub-test's own
+// testing.T, with the standard "--- FAIL: TestX/name" formatting and timing,
+// and `go test -run TestX/name` selects it like any other sub-test. Run must
+// be called from inside a Terst body.
+func Run(name string, fn func()) {
+	parent := currentScope()
+	if parent == nil {
+		panic("terst.Run called outside a Terst scope")
 	}
-}
-
-func (scope *_scope) log(call _entry, str string) {
-	scope.mu.Lock()
-	defer scope.mu.Unlock()
-	scope.output = append(scope.output, decorate(call, str)...)
-}
-
-// decorate prefixes the string with the file and line of the call site
-// and inserts the final newline if needed and indentation tabs for formascing.
-func decorate(call _entry, s string) string {
+	parent.t.Helper()
 
-	file, line := call.File, call.Line
-	if call.PC > 0 {
-		// Truncate file name at last file name separator.
-		if index := strings.LastIndex(file, "/"); index >= 0 {
-			file = file[index+1:]
-		} else if index = strings.LastIndex(file, "\\"); index >= 0 {
-			file = file[index+1:]
-		}
-	} else {
-		file = "???"
-		line = 1
-	}
-	buf := new(bytes.Buffer)
-	// Every line is indented at least one tab.
-	buf.WriteByte('\t')
-	fmt.Fprintf(buf, "%s:%d: ", file, line)
-	lines := strings.Split(s, "\n")
-	if l := len(lines); l > 1 && lines[l-1] == "" {
-		lines = lines[:l-1]
-	}
-	for i, line := range lines {
-		if i > 0 {
-			// Second and subsequent lines are indented an extra tab.
-			buf.WriteString("\n\t\t")
-		}
-		buf.WriteString(line)
-	}
-	buf.WriteByte('\n')
-	return buf.String()
-}
-
-func findScope() (*_scope, _entry) {
-	registry.lock.RLock()
-	defer registry.lock.RUnlock()
-	table := registry.table
-	depth := 2 // Starting depth
-	call := _entry{}
-	for {
-		pc, _, _, ok := runtime.Caller(depth)
-		if !ok {
-			break
-		}
-		if scope, exists := table[pc]; exists {
-			pc, file, line, _ := runtime.Caller(depth - 3) // Terst(...) + func(){}() + fn() => ???()
-			call.PC = pc
-			call.File = file
-			call.Line = line
-			return scope, call
-		}
-		depth++
-	}
-	return nil, _entry{}
+	parent.t.Run(name, func(sub *testing.T) {
+		sub.Helper()
+		registerScope(sub, &_scope{t: sub})
+		fn()
+	})
 }
 
 // Call is a reference to a line immediately under a Terst testing scope.
 type Call struct {
 	scope *_scope
-	entry _entry
 }
 
-// Caller will search the stack, looking for a Terst testing scope. If a scope
-// is found, then Caller returns a Call for logging errors, accessing testing.T, etc.
-// If no scope is found, Caller returns nil.
+// Caller looks up the Terst scope for the calling goroutine. If one is
+// found, Caller returns a Call for logging errors, accessing testing.T,
+// etc.; otherwise it returns nil.
 func Caller() *Call {
-	scope, entry := findScope()
+	scope := currentScope()
 	if scope == nil {
 		return nil
 	}
-	return &Call{
-		scope: scope,
-		entry: entry,
-	}
-}
-
-// TestFunc returns the *runtime.Func entry for the top-level Test...(t testing.T)
-// function.
-func (cl *Call) TestFunc() *runtime.Func {
-	return cl.scope.testFunc
+	return &Call{scope: scope}
 }
 
-// T returns the original testing.T passed to Terst(...)
+// T returns the testing.T of the enclosing Terst scope (the top-level one,
+// or the current sub-test's if called from inside Run).
 func (cl *Call) T() *testing.T {
 	return cl.scope.t
 }
 
 // Log is the terst version of `testing.T.Log`
-func (cl *Call) Log(arguments ...interface{}) {
-	cl.scope.log(cl.entry, fmt.Sprintln(arguments...))
+func (cl *Call) Log(arguments ...any) {
+	cl.scope.t.Helper()
+	cl.scope.t.Log(arguments...)
 }
 
 // Logf is the terst version of `testing.T.Logf`
-func (cl *Call) Logf(format string, arguments ...interface{}) {
-	cl.scope.log(cl.entry, fmt.Sprintf(format, arguments...))
+func (cl *Call) Logf(format string, arguments ...any) {
+	cl.scope.t.Helper()
+	cl.scope.t.Logf(format, arguments...)
 }
 
 // Error is the terst version of `testing.T.Error`
-func (cl *Call) Error(arguments ...interface{}) {
-	cl.scope.log(cl.entry, fmt.Sprintln(arguments...))
-	cl.scope.t.Fail()
+func (cl *Call) Error(arguments ...any) {
+	cl.scope.t.Helper()
+	cl.scope.t.Error(arguments...)
 }
 
 // Errorf is the terst version of `testing.T.Errorf`
-func (cl *Call) Errorf(format string, arguments ...interface{}) {
-	cl.scope.log(cl.entry, fmt.Sprintf(format, arguments...))
-	cl.scope.t.Fail()
+func (cl *Call) Errorf(format string, arguments ...any) {
+	cl.scope.t.Helper()
+	cl.scope.t.Errorf(format, arguments...)
 }
 
 // Skip is the terst version of `testing.T.Skip`
-func (cl *Call) Skip(arguments ...interface{}) {
-	cl.scope.log(cl.entry, fmt.Sprintln(arguments...))
-	cl.scope.t.SkipNow()
+func (cl *Call) Skip(arguments ...any) {
+	cl.scope.t.Helper()
+	cl.scope.t.Skip(arguments...)
 }
 
 // Skipf is the terst version of `testing.T.Skipf`
-func (cl *Call) Skipf(format string, arguments ...interface{}) {
-	cl.scope.log(cl.entry, fmt.Sprintf(format, arguments...))
-	cl.scope.t.SkipNow()
+func (cl *Call) Skipf(format string, arguments ...any) {
+	cl.scope.t.Helper()
+	cl.scope.t.Skipf(format, arguments...)
 }
 
 type _scope struct {
-	t        *testing.T
-	testFunc *runtime.Func
-	name     string
-	mu       sync.RWMutex
-	output   []byte
-	start    time.Time
-	duration time.Duration
-}
-
-type _entry struct {
-	PC   uintptr
-	File string
-	Line int
-	Func *runtime.Func
-}
-
-func _findFunc(match string) (_entry, *runtime.Func) {
-	depth := 2 // Starting depth
-	for {
-		pc, file, line, ok := runtime.Caller(depth)
-		if !ok {
-			break
-		}
-		fn := runtime.FuncForPC(pc)
-		name := fn.Name()
-		if index := strings.LastIndex(name, match); index >= 0 {
-			// Assume we have an instance of TestXyzzy in a _test file
-			return _entry{
-				PC:   pc,
-				File: file,
-				Line: line,
-				Func: fn,
-			}, fn
-		}
-		depth++
-	}
-	return _entry{}, nil
-}
-
-func findTestFunc() (_entry, *runtime.Func) {
-	return _findFunc(".Test")
-}
-
-func findTerstFunc() (_entry, *runtime.Func) {
-	return _findFunc(".Terst")
+	t *testing.T
 }
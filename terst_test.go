@@ -1,44 +1,113 @@
 package terst
 
 import (
+	"errors"
 	"testing"
-    "fmt"
-    "math"
 )
 
-func Test(t *testing.T) {
-    a := uint16(0x10F0)
-    b := int8(a)
-    c := uint32(b)
-    fmt.Printf("%v %v %v %v\n", a, b, c, int64(a))
-
-
-    /*result := uint64(math.MaxUint64) > int64(math.MaxInt32)*/
-    /*fmt.Printf("%v", result)*/
-	WithTester(t)
-    Like(1, 1.1)
-    Is(1, 1.0, "Abc")
-    Compare(1, "==", 1.0)
-    Compare(uint64(math.MaxUint64), "<", int64(math.MinInt32))
-    return
-    Compare(false, "<", true)
-    Compare("apple", "==", "banana")
-    Compare("apple", "==", true)
-    Compare(false, "==", true)
-    Compare(uint(1), "==", int(2))
-    Compare(uint(1), "==", 1.1)
-
-	Equal("apple", "orange")
-	Is("apple", "apple")
-	IsNot("apple", "orange")
-	Unlike("apple", `pp`)
-	Pass(false)
-	Fail(true)
-    Compare(1, "==", 1)
-    Compare(10, "<", 4.0)
-    Compare(6, ">", 6.0)
-    Compare("abcd", "<", "abc")
-    Compare("ab", ">=", "abc")
-    Compare("abc", ">=", "abc")
-    Compare(math.Inf(0), ">", 2)
+func TestIsAndCompare(t *testing.T) {
+	Terst(t, func() {
+		Is(1, 1)
+		Is(1, "==", 1.0)
+		Compare(1, "<", 2)
+		Equal("apple", "apple")
+		Unlike("apple", "orange")
+		Pass(true)
+		Fail(false)
+	})
+}
+
+func TestMustIsStopsTheScopeOnFailure(t *testing.T) {
+	reached := false
+	sub := &testing.T{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Terst(sub, func() {
+			MustIs(1, 2)
+			reached = true
+		})
+	}()
+	<-done
+
+	if reached {
+		t.Fatal("MustIs should have aborted the scope via FailNow, not continued")
+	}
+	if !sub.Failed() {
+		t.Fatal("expected the scope's testing.T to be marked as failed")
+	}
+}
+
+type alwaysOdd struct {
+	value int
+}
+
+func oddComparison(v alwaysOdd) Comparison {
+	return func() (bool, string) {
+		if v.value%2 == 1 {
+			return true, ""
+		}
+		return false, "not odd"
+	}
+}
+
+func TestIsWithComparison(t *testing.T) {
+	Terst(t, func() {
+		if !Is(oddComparison(alwaysOdd{value: 3})) {
+			t.Fatal("expected oddComparison(3) to pass")
+		}
+	})
+}
+
+func TestMustVariants(t *testing.T) {
+	Terst(t, func() {
+		if !MustCompare(1, "<", 2) {
+			t.Fatal("expected MustCompare(1, <, 2) to pass")
+		}
+		if !MustEqual("apple", "apple") {
+			t.Fatal("expected MustEqual to pass for equal values")
+		}
+		if !MustUnlike("apple", "orange") {
+			t.Fatal("expected MustUnlike to pass for different values")
+		}
+		if !MustMatch("Nothing happens.", `ing(\s+)happens\.$`) {
+			t.Fatal("expected MustMatch to pass for a matching regexp")
+		}
+	})
+}
+
+func TestMustIsWithComparisonStopsTheScopeOnFailure(t *testing.T) {
+	reached := false
+	sub := &testing.T{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Terst(sub, func() {
+			MustIs(oddComparison(alwaysOdd{value: 4}))
+			reached = true
+		})
+	}()
+	<-done
+
+	if reached {
+		t.Fatal("MustIs(Comparison) should have aborted the scope via FailNow, not continued")
+	}
+	if !sub.Failed() {
+		t.Fatal("expected the scope's testing.T to be marked as failed")
+	}
+}
+
+func TestIsErrInvalidComparator(t *testing.T) {
+	err := IsErr(1, "nope", 2)
+	if err == nil {
+		t.Fatal("expected an error for an unknown comparator")
+	}
+}
+
+func TestIsErrReportsFailure(t *testing.T) {
+	err := IsErr(1, 2)
+	var failErr ErrFail
+	if !errors.As(err, &failErr) {
+		t.Fatalf("expected an ErrFail, got %T: %v", err, err)
+	}
 }